@@ -0,0 +1,21 @@
+package superevents
+
+import (
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// ManagedNodeUnhealthyEvent is emitted when a ManagedNode has observed enough
+// consecutive subscription or RPC failures that it can no longer be trusted
+// to deliver events, and the supervisor should consider routing around it
+// (e.g. failing over to a backup node, or surfacing the chain as degraded).
+type ManagedNodeUnhealthyEvent struct {
+	ChainID eth.ChainID
+	// Err is the most recent error that triggered the unhealthy classification.
+	Err error
+}
+
+func (ev ManagedNodeUnhealthyEvent) String() string {
+	return fmt.Sprintf("ManagedNodeUnhealthyEvent(chain: %s, err: %v)", ev.ChainID, ev.Err)
+}
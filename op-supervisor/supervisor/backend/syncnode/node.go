@@ -9,7 +9,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/ethereum-optimism/optimism/op-service/rpc"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum/go-ethereum"
@@ -34,11 +33,266 @@ type backend interface {
 const (
 	internalTimeout            = time.Second * 30
 	nodeTimeout                = time.Second * 10
-	maxWalkBackAttempts        = 300
 	blockNotFoundRPCErrCode    = -39001
 	conflictingBlockRPCErrCode = -39002
+	// linearProbeDepth bounds the final linear-probe phase that follows the
+	// bisection in resolveConflict: once the search window is this narrow,
+	// we step through it one block at a time rather than keep bisecting, so
+	// we don't reset past a block the node transiently rejected.
+	linearProbeDepth = 4
+
+	// nodeErrBackoffBase is the backoff delay for the first failure in a
+	// streak. Only reset attempts wait it out (see resetBackoff); for other
+	// node failures (subscription loss, or a failed RPC call) it is logged.
+	nodeErrBackoffBase = time.Second
+	// nodeErrBackoffMax caps the exponential backoff so we don't end up
+	// waiting indefinitely between retries on a persistently failing node.
+	nodeErrBackoffMax = time.Minute * 2
+	// nodeErrUnhealthyThreshold is the number of consecutive failures after
+	// which the node is considered unhealthy, rather than transiently flaky.
+	nodeErrUnhealthyThreshold = 5
+
+	// pollBaseInterval is the starting interval for the adaptive-polling
+	// fallback, used when the node does not support RPC notifications.
+	pollBaseInterval = time.Millisecond * 100
+	// pollFloorInterval is the fastest the adaptive poller will go, even
+	// after repeated non-empty polls.
+	pollFloorInterval = time.Millisecond * 25
+	// pollCeilInterval is the slowest the adaptive poller will go, even
+	// after repeated empty polls on an idle chain.
+	pollCeilInterval = time.Second * 2
 )
 
+// nodeErrKind classifies an error observed from a managed node, so the
+// supervisor can tell transient hiccups apart from terminal failures.
+type nodeErrKind string
+
+const (
+	nodeErrSubscriptionLost nodeErrKind = "subscription_lost"
+	nodeErrBlockNotFound    nodeErrKind = "block_not_found"
+	nodeErrConflictingBlock nodeErrKind = "conflicting_block"
+	nodeErrDeadlineExceeded nodeErrKind = "deadline_exceeded"
+	nodeErrUnknown          nodeErrKind = "unknown"
+)
+
+// classifyNodeErr inspects an error bubbled up from subscriptions or RPC
+// calls against the node, and returns the most specific classification it
+// can find.
+func classifyNodeErr(err error) nodeErrKind {
+	if err == nil {
+		return ""
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nodeErrDeadlineExceeded
+	}
+	var rpcErr *gethrpc.JsonError
+	if errors.As(err, &rpcErr) {
+		switch rpcErr.Code {
+		case blockNotFoundRPCErrCode:
+			return nodeErrBlockNotFound
+		case conflictingBlockRPCErrCode:
+			return nodeErrConflictingBlock
+		}
+	}
+	if strings.HasPrefix(err.Error(), string(nodeErrSubscriptionLost)+":") {
+		return nodeErrSubscriptionLost
+	}
+	return nodeErrUnknown
+}
+
+// nodeErrBackoff tracks a node's consecutive-failure streak across every
+// kind of subscription/RPC failure, and escalates from logging to a single
+// superevents.ManagedNodeUnhealthyEvent once failures keep recurring,
+// instead of retrying quietly forever or re-emitting on every failure once
+// the node is already known to be unhealthy.
+type nodeErrBackoff struct {
+	mu       sync.Mutex
+	streak   int
+	lastErr  error
+	reported bool
+}
+
+// record registers a new failure and returns the delay the caller may use
+// for logging, along with whether this failure is the one that just crossed
+// nodeErrUnhealthyThreshold. becameUnhealthy is only true on the transition
+// into the unhealthy state, not on every failure after it.
+func (b *nodeErrBackoff) record(err error) (delay time.Duration, becameUnhealthy bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.streak++
+	b.lastErr = err
+	delay = nodeErrBackoffBase * time.Duration(1<<uint(b.streak-1))
+	if delay > nodeErrBackoffMax || delay <= 0 {
+		delay = nodeErrBackoffMax
+	}
+	unhealthy := b.streak >= nodeErrUnhealthyThreshold
+	becameUnhealthy = unhealthy && !b.reported
+	b.reported = b.reported || unhealthy
+	return delay, becameUnhealthy
+}
+
+// reset clears the failure streak and the reported-unhealthy bit, e.g. after
+// a successful call to the node.
+func (b *nodeErrBackoff) reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.streak = 0
+	b.lastErr = nil
+	b.reported = false
+}
+
+// resetBackoff tracks a consecutive-failure streak across reset attempts
+// specifically (resolveConflict, reset-on-future, reset-on-out-of-order, and
+// sendReset), and exposes a non-blocking check for whether enough time has
+// passed since the last failure to try again. It is kept separate from
+// nodeErrBackoff so an unrelated failure elsewhere on the node (e.g. a
+// ProvideL1 hiccup) can't gate an unrelated reset attempt, and it never
+// blocks, since resets are triggered from the deriver's synchronous OnEvent
+// path.
+type resetBackoff struct {
+	mu          sync.Mutex
+	streak      int
+	nextAllowed time.Time
+}
+
+// ready reports whether enough time has passed since the last recorded
+// reset failure to attempt another one. It never blocks or sleeps.
+func (b *resetBackoff) ready() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !time.Now().Before(b.nextAllowed)
+}
+
+// recordFailure registers a failed reset attempt and returns the delay
+// before the next one is allowed.
+func (b *resetBackoff) recordFailure() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.streak++
+	delay := nodeErrBackoffBase * time.Duration(1<<uint(b.streak-1))
+	if delay > nodeErrBackoffMax || delay <= 0 {
+		delay = nodeErrBackoffMax
+	}
+	b.nextAllowed = time.Now().Add(delay)
+	return delay
+}
+
+// recordSuccess clears the reset-failure streak after a reset succeeds.
+func (b *resetBackoff) recordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.streak = 0
+	b.nextAllowed = time.Time{}
+}
+
+// adaptivePoller polls Node.PullEvent on an AIMD-adjusted interval: it halves
+// the interval (down to pollFloorInterval) whenever a poll turns up an event,
+// and additively increases it by pollBaseInterval (up to pollCeilInterval) on
+// each empty poll. This keeps the polling fallback responsive on busy chains
+// without hammering an idle one. It satisfies gethevent.Subscription, so it
+// can be used as a drop-in replacement for rpc.StreamFallback's subscription.
+type adaptivePoller struct {
+	pull     func(ctx context.Context) (*types.ManagedEvent, error)
+	sink     chan<- *types.ManagedEvent
+	interval time.Duration
+
+	kick   chan struct{}
+	errC   chan error
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newAdaptivePoller(ctx context.Context, pull func(ctx context.Context) (*types.ManagedEvent, error), base time.Duration, sink chan<- *types.ManagedEvent) *adaptivePoller {
+	ctx, cancel := context.WithCancel(ctx)
+	p := &adaptivePoller{
+		pull:     pull,
+		sink:     sink,
+		interval: base,
+		kick:     make(chan struct{}, 1),
+		errC:     make(chan error, 1),
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	go p.loop(ctx)
+	return p
+}
+
+func (p *adaptivePoller) loop(ctx context.Context) {
+	defer close(p.done)
+	timer := time.NewTimer(p.interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.kick:
+			if !timer.Stop() {
+				<-timer.C
+			}
+		case <-timer.C:
+		}
+
+		gotEvent, err := p.pollOnce(ctx)
+		if err != nil {
+			select {
+			case p.errC <- err:
+			default:
+			}
+			return
+		}
+
+		if gotEvent {
+			p.interval /= 2
+			if p.interval < pollFloorInterval {
+				p.interval = pollFloorInterval
+			}
+		} else {
+			p.interval += pollBaseInterval
+			if p.interval > pollCeilInterval {
+				p.interval = pollCeilInterval
+			}
+		}
+		timer.Reset(p.interval)
+	}
+}
+
+func (p *adaptivePoller) pollOnce(ctx context.Context) (gotEvent bool, err error) {
+	ev, err := p.pull(ctx)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return false, nil
+		}
+		return false, err
+	}
+	select {
+	case p.sink <- ev:
+		return true, nil
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+}
+
+// Kick forces an immediate poll, without waiting for the next scheduled
+// tick. Used e.g. when the supervisor emits an update it expects the node
+// to ack promptly, rather than on the poller's own schedule.
+func (p *adaptivePoller) Kick() {
+	select {
+	case p.kick <- struct{}{}:
+	default:
+	}
+}
+
+func (p *adaptivePoller) Err() <-chan error {
+	return p.errC
+}
+
+func (p *adaptivePoller) Unsubscribe() {
+	p.cancel()
+	<-p.done
+}
+
+var _ gethevent.Subscription = (*adaptivePoller)(nil)
+
 type ManagedNode struct {
 	log     log.Logger
 	Node    SyncControl
@@ -52,6 +306,18 @@ type ManagedNode struct {
 
 	subscriptions []gethevent.Subscription
 
+	// poller is set while the node events subscription has fallen back to
+	// adaptive polling, and nil while RPC notifications are in use.
+	pollerMu sync.Mutex
+	poller   *adaptivePoller
+
+	// nodeErrors carries subscription and RPC failures observed while
+	// talking to the node, so they can be classified and escalated instead
+	// of only being logged where they occur.
+	nodeErrors   chan error
+	errBackoff   nodeErrBackoff
+	resetBackoff resetBackoff
+
 	emitter event.Emitter
 
 	ctx    context.Context
@@ -65,12 +331,13 @@ var _ event.Deriver = (*ManagedNode)(nil)
 func NewManagedNode(log log.Logger, id eth.ChainID, node SyncControl, backend backend, noSubscribe bool) *ManagedNode {
 	ctx, cancel := context.WithCancel(context.Background())
 	m := &ManagedNode{
-		log:     log.New("chain", id),
-		backend: backend,
-		Node:    node,
-		chainID: id,
-		ctx:     ctx,
-		cancel:  cancel,
+		log:        log.New("chain", id),
+		backend:    backend,
+		Node:       node,
+		chainID:    id,
+		nodeErrors: make(chan error, 10),
+		ctx:        ctx,
+		cancel:     cancel,
 	}
 	if !noSubscribe {
 		m.SubscribeToNodeEvents()
@@ -79,8 +346,14 @@ func NewManagedNode(log log.Logger, id eth.ChainID, node SyncControl, backend ba
 	return m
 }
 
+// AttachEmitter wires up the emitter used to report events, and only then
+// starts WatchNodeErrors: that goroutine reads m.emitter when escalating to
+// an unhealthy event, and every other deriver method in this file already
+// assumes AttachEmitter happens-before any deriver logic runs. Starting it
+// earlier, from the constructor, would race with this assignment.
 func (m *ManagedNode) AttachEmitter(em event.Emitter) {
 	m.emitter = em
+	m.WatchNodeErrors()
 }
 
 func (m *ManagedNode) OnEvent(ev event.Event) bool {
@@ -135,28 +408,44 @@ func (m *ManagedNode) SubscribeToNodeEvents() {
 			sub, err := m.Node.SubscribeEvents(ctx, m.nodeEvents)
 			if err != nil {
 				if errors.Is(err, gethrpc.ErrNotificationsUnsupported) {
-					m.log.Warn("No RPC notification support detected, falling back to polling")
-					// fallback to polling if subscriptions are not supported.
-					sub, err := rpc.StreamFallback[types.ManagedEvent](
-						m.Node.PullEvent, time.Millisecond*100, m.nodeEvents)
-					if err != nil {
-						m.log.Error("Failed to start RPC stream fallback", "err", err)
-						return nil, err
-					}
-					return sub, err
+					m.log.Warn("No RPC notification support detected, falling back to adaptive polling")
+					// fallback to adaptive-interval polling if subscriptions are not supported.
+					poller := newAdaptivePoller(ctx, m.Node.PullEvent, pollBaseInterval, m.nodeEvents)
+					m.pollerMu.Lock()
+					m.poller = poller
+					m.pollerMu.Unlock()
+					return poller, nil
 				}
 				return nil, err
 			}
+			m.pollerMu.Lock()
+			m.poller = nil
+			m.pollerMu.Unlock()
 			return sub, nil
 		}))
 }
 
+// PollNow forces the adaptive-polling fallback, if currently active, to poll
+// immediately rather than waiting for its next scheduled tick. For example,
+// the supervisor can call this after emitting an update it expects the node
+// to ack promptly. It has no effect if the node is using RPC notification
+// subscriptions instead of polling.
+func (m *ManagedNode) PollNow() {
+	m.pollerMu.Lock()
+	poller := m.poller
+	m.pollerMu.Unlock()
+	if poller != nil {
+		poller.Kick()
+	}
+}
+
 func (m *ManagedNode) WatchSubscriptionErrors() {
 	watchSub := func(sub ethereum.Subscription) {
 		defer m.wg.Done()
 		select {
 		case err := <-sub.Err():
 			m.log.Error("Subscription error", "err", err)
+			m.reportNodeError(fmt.Errorf("%s: %w", nodeErrSubscriptionLost, err))
 		case <-m.ctx.Done():
 			// we're closing, stop watching the subscription
 		}
@@ -167,6 +456,72 @@ func (m *ManagedNode) WatchSubscriptionErrors() {
 	}
 }
 
+// WatchNodeErrors drains nodeErrors, classifying each one and tracking a
+// per-node failure streak with exponential backoff. The first time the
+// streak crosses nodeErrUnhealthyThreshold, the node is marked unhealthy via
+// a superevents.ManagedNodeUnhealthyEvent, so the supervisor can fail over
+// to a backup node or otherwise stop relying on it, instead of retrying
+// quietly in the logs forever. Later failures while still unhealthy are
+// logged but don't re-emit the event; reset() clears the reported bit so
+// the next unhealthy transition fires again.
+func (m *ManagedNode) WatchNodeErrors() {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		for {
+			select {
+			case err := <-m.nodeErrors:
+				kind := classifyNodeErr(err)
+				delay, becameUnhealthy := m.errBackoff.record(err)
+				m.log.Warn("Observed node error", "kind", kind, "err", err, "backoff", delay)
+				if becameUnhealthy && m.emitter != nil {
+					m.log.Error("Node has exceeded error threshold, marking unhealthy", "kind", kind, "err", err)
+					m.emitter.Emit(superevents.ManagedNodeUnhealthyEvent{
+						ChainID: m.chainID,
+						Err:     err,
+					})
+				}
+			case <-m.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// reportNodeError routes a classified node failure to WatchNodeErrors,
+// without blocking the caller if the channel is momentarily full.
+func (m *ManagedNode) reportNodeError(err error) {
+	select {
+	case m.nodeErrors <- err:
+	default:
+		m.log.Warn("Dropping node error, error channel is full", "err", err)
+	}
+}
+
+// reportNodeSuccess clears the node's consecutive-failure streak after an
+// RPC call to it succeeds, so a recovered node stops being treated as
+// unhealthy.
+func (m *ManagedNode) reportNodeSuccess() {
+	m.errBackoff.reset()
+}
+
+// reportResetFailure routes a failed reset attempt to WatchNodeErrors like
+// any other node error, and additionally records it against resetBackoff,
+// which gates when the next reset attempt is allowed to run.
+func (m *ManagedNode) reportResetFailure(err error) {
+	m.reportNodeError(err)
+	delay := m.resetBackoff.recordFailure()
+	m.log.Warn("Reset attempt failed, backing off before the next attempt", "err", err, "backoff", delay)
+}
+
+// reportResetSuccess clears both the node's overall failure streak and the
+// reset-specific backoff, since a successful reset demonstrates the node is
+// reachable and has accepted the new head.
+func (m *ManagedNode) reportResetSuccess() {
+	m.reportNodeSuccess()
+	m.resetBackoff.recordSuccess()
+}
+
 func (m *ManagedNode) Start() {
 	m.wg.Add(1)
 	go func() {
@@ -242,8 +597,10 @@ func (m *ManagedNode) onCrossUnsafeUpdate(seal types.BlockSeal) {
 	err := m.Node.UpdateCrossUnsafe(ctx, id)
 	if err != nil {
 		m.log.Warn("Node failed cross-unsafe updating", "err", err)
+		m.reportNodeError(fmt.Errorf("cross-unsafe update: %w", err))
 		return
 	}
+	m.reportNodeSuccess()
 }
 
 func (m *ManagedNode) onCrossSafeUpdate(pair types.DerivedBlockSealPair) {
@@ -254,8 +611,13 @@ func (m *ManagedNode) onCrossSafeUpdate(pair types.DerivedBlockSealPair) {
 	err := m.Node.UpdateCrossSafe(ctx, pairIDs.Derived, pairIDs.DerivedFrom)
 	if err != nil {
 		m.log.Warn("Node failed cross-safe updating", "err", err)
+		m.reportNodeError(fmt.Errorf("cross-safe update: %w", err))
 		return
 	}
+	m.reportNodeSuccess()
+	// We expect the node to ack this with a derivation-update event soon
+	// after; if we've fallen back to polling, don't wait for the next tick.
+	m.PollNow()
 }
 
 func (m *ManagedNode) onFinalizedL2(seal types.BlockSeal) {
@@ -266,8 +628,10 @@ func (m *ManagedNode) onFinalizedL2(seal types.BlockSeal) {
 	err := m.Node.UpdateFinalized(ctx, id)
 	if err != nil {
 		m.log.Warn("Node failed finality updating", "err", err)
+		m.reportNodeError(fmt.Errorf("finality update: %w", err))
 		return
 	}
+	m.reportNodeSuccess()
 }
 
 func (m *ManagedNode) onUnsafeBlock(unsafeRef eth.BlockRef) {
@@ -302,6 +666,13 @@ func (m *ManagedNode) resetSignal(errSignal error, l1Ref eth.BlockRef) {
 	// if future error -> send reset to rewind
 	// if out of order -> warn, just old data
 	// TODO(#13971): When there are errors getting these blocks, we shouldn't always exit early.
+	// Respect the backoff from any earlier reset failure, so repeated
+	// failures are actually spaced out rather than retried immediately. This
+	// is a non-blocking check: OnEvent must not stall on it.
+	if !m.resetBackoff.ready() {
+		m.log.Debug("Skipping reset, still backing off from a prior reset failure")
+		return
+	}
 	ctx, cancel := context.WithTimeout(m.ctx, internalTimeout)
 	defer cancel()
 	u, err := m.backend.LocalUnsafe(ctx, m.chainID)
@@ -321,6 +692,7 @@ func (m *ManagedNode) resetSignal(errSignal error, l1Ref eth.BlockRef) {
 	case errors.Is(errSignal, types.ErrConflict):
 		if err := m.resolveConflict(ctx, l1Ref, u, f); err != nil {
 			m.log.Warn("Failed to resolve conflict", "unsafe", u, "finalized", f)
+			m.reportResetFailure(fmt.Errorf("resolve conflict: %w", err))
 			return
 		}
 	case errors.Is(errSignal, types.ErrFuture):
@@ -332,6 +704,9 @@ func (m *ManagedNode) resetSignal(errSignal error, l1Ref eth.BlockRef) {
 		err = m.Node.Reset(ctx, u, s.Derived, f)
 		if err != nil {
 			m.log.Warn("Node failed to reset", "err", err)
+			m.reportResetFailure(fmt.Errorf("reset on future block: %w", err))
+		} else {
+			m.reportResetSuccess()
 		}
 	case errors.Is(errSignal, types.ErrOutOfOrder):
 		s, err := m.backend.LocalSafe(ctx, m.chainID)
@@ -343,11 +718,21 @@ func (m *ManagedNode) resetSignal(errSignal error, l1Ref eth.BlockRef) {
 		err = m.Node.Reset(ctx, u, s.Derived, f)
 		if err != nil {
 			m.log.Warn("Node failed to reset", "err", err)
+			m.reportResetFailure(fmt.Errorf("reset on out-of-order block: %w", err))
+		} else {
+			m.reportResetSuccess()
 		}
 	}
 }
 
 func (m *ManagedNode) sendReset() {
+	// Respect the backoff from any earlier reset failure, so repeated
+	// failures are actually spaced out rather than retried immediately. This
+	// is a non-blocking check: OnEvent must not stall on it.
+	if !m.resetBackoff.ready() {
+		m.log.Debug("Skipping reset, still backing off from a prior reset failure")
+		return
+	}
 	ctx, cancel := context.WithTimeout(m.ctx, internalTimeout)
 	defer cancel()
 
@@ -373,13 +758,16 @@ func (m *ManagedNode) sendReset() {
 
 	if err := m.Node.Reset(ctx, u, s.Derived, f); err != nil {
 		m.log.Warn("Node failed to reset", "err", err)
+		m.reportResetFailure(fmt.Errorf("reset: %w", err))
 		return
 	}
+	m.reportResetSuccess()
 }
 
 // resolveConflict attempts to reset the node to a valid state when a conflict is detected.
-// It first tries using the latest safe block, and if that fails, walks back block by block
-// until it finds a common ancestor or reaches the finalized block.
+// It first tries using the latest safe block, and if that fails, bisects the range between
+// the finalized block and the latest safe block to find a common ancestor in O(log n) resets,
+// rather than walking back one block at a time.
 func (m *ManagedNode) resolveConflict(ctx context.Context, l1Ref eth.BlockRef, u eth.BlockID, f eth.BlockID) error {
 	// First try to reset to the last known safe block
 	s, err := m.backend.SafeDerivedAt(ctx, m.chainID, l1Ref.ID())
@@ -387,10 +775,11 @@ func (m *ManagedNode) resolveConflict(ctx context.Context, l1Ref eth.BlockRef, u
 		return fmt.Errorf("failed to retrieve safe block for %v: %w", l1Ref.ID(), err)
 	}
 
-	// Helper to attempt a reset and classify the error
-	tryReset := func(safe eth.BlockID) (resolved bool, needsWalkback bool, err error) {
+	// Helper to attempt a reset to the given safe block, and classify the error.
+	resetTo := func(safe eth.BlockID) (resolved bool, needsWalkback bool, err error) {
 		m.log.Debug("Attempting reset", "unsafe", u, "safe", safe, "finalized", f)
 		if err := m.Node.Reset(ctx, u, safe, f); err == nil {
+			m.reportResetSuccess()
 			return true, false, nil
 		} else {
 			var rpcErr *gethrpc.JsonError
@@ -401,8 +790,17 @@ func (m *ManagedNode) resolveConflict(ctx context.Context, l1Ref eth.BlockRef, u
 		}
 	}
 
+	// Helper to attempt a reset to the safe block derived from the given number.
+	tryReset := func(number uint64) (resolved bool, needsWalkback bool, err error) {
+		safe, err := m.backend.SafeDerivedAt(ctx, m.chainID, eth.BlockID{Number: number})
+		if err != nil {
+			return false, false, fmt.Errorf("failed to retrieve safe block %d: %w", number, err)
+		}
+		return resetTo(safe)
+	}
+
 	// Try initial reset
-	resolved, needsWalkback, err := tryReset(s)
+	resolved, needsWalkback, err := resetTo(s)
 	if resolved {
 		return nil
 	}
@@ -410,30 +808,69 @@ func (m *ManagedNode) resolveConflict(ctx context.Context, l1Ref eth.BlockRef, u
 		return fmt.Errorf("error during reset: %w", err)
 	}
 
-	// Walk back one block at a time looking for a common ancestor
-	currentBlock := s.Number
-	for i := 0; i < maxWalkBackAttempts; i++ {
-		currentBlock--
-		if currentBlock <= f.Number {
-			return fmt.Errorf("reached finalized block %d without finding common ancestor", f.Number)
-		}
+	// Bisect over [f.Number, s.Number] for the highest block that resets
+	// cleanly.
+	target, ok, err := resolveConflictSearch(f.Number, s.Number, tryReset)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("reached finalized block %d without finding common ancestor", f.Number)
+	}
+	m.log.Debug("Resolved conflict via bisection", "target", target, "finalized", f.Number, "safe", s.Number)
+	return nil
+}
 
-		safe, err := m.backend.SafeDerivedAt(ctx, m.chainID, eth.BlockID{Number: currentBlock})
-		if err != nil {
-			return fmt.Errorf("failed to retrieve safe block %d: %w", currentBlock, err)
+// resolveConflictSearch finds the highest block number in (lo, hi] that
+// tryReset accepts, using bisection down to a small window and then a
+// linear probe of that window from the top down. The initial lo is a floor
+// that is never passed to tryReset, so an ancestor at lo itself is reported
+// as not found.
+//
+// The predicate is expected to be monotonic: tryReset succeeds for every
+// number at or below the true common ancestor, and fails with needsWalkback
+// for every number above it. A successful reset at mid means the ancestor is
+// at or after mid, so the search narrows upward (lo = mid); a
+// block-not-found/conflicting-block error means it's before mid, so the
+// search narrows downward (hi = mid - 1).
+//
+// If the bisection phase confirms a successful reset but the subsequent
+// linear probe of the narrowed window finds nothing higher, ok is true and
+// target is that already-confirmed point — the node is sitting in a valid
+// reset state there, even though the probe couldn't do better. ok is false
+// only if no call to tryReset ever succeeded.
+func resolveConflictSearch(lo, hi uint64, tryReset func(number uint64) (resolved bool, needsWalkback bool, err error)) (target uint64, ok bool, err error) {
+	haveConfirmed := false
+	for hi-lo > linearProbeDepth {
+		mid := lo + (hi-lo+1)/2
+		resolved, needsWalkback, err := tryReset(mid)
+		if resolved {
+			lo = mid
+			haveConfirmed = true
+			continue
 		}
+		if !needsWalkback {
+			return 0, false, fmt.Errorf("error during reset at block %d: %w", mid, err)
+		}
+		hi = mid - 1
+	}
 
-		resolved, _, err := tryReset(safe)
+	// Linear-probe the remaining narrow window, from the high end down, in
+	// case the bisection landed past a block the node transiently rejected
+	// rather than the true common ancestor.
+	for current := hi; current > lo; current-- {
+		resolved, needsWalkback, err := tryReset(current)
 		if resolved {
-			return nil
+			return current, true, nil
 		}
-		// Continue walking back on walkable errors, otherwise return the error
-		var rpcErr *gethrpc.JsonError
-		if !errors.As(err, &rpcErr) || (rpcErr.Code != blockNotFoundRPCErrCode && rpcErr.Code != conflictingBlockRPCErrCode) {
-			return fmt.Errorf("error during reset at block %d: %w", currentBlock, err)
+		if !needsWalkback {
+			return 0, false, fmt.Errorf("error during reset at block %d: %w", current, err)
 		}
 	}
-	return fmt.Errorf("exceeded maximum walk-back attempts (%d)", maxWalkBackAttempts)
+	if haveConfirmed {
+		return lo, true, nil
+	}
+	return 0, false, nil
 }
 
 func (m *ManagedNode) onExhaustL1Event(completed types.DerivedBlockRefPair) {
@@ -448,6 +885,7 @@ func (m *ManagedNode) onExhaustL1Event(completed types.DerivedBlockRefPair) {
 			return
 		}
 		m.log.Error("Failed to retrieve next L1 block for node", "l1Block", completed.DerivedFrom, "err", err)
+		m.reportNodeError(fmt.Errorf("retrieve next L1 block: %w", err))
 		return
 	}
 
@@ -455,11 +893,13 @@ func (m *ManagedNode) onExhaustL1Event(completed types.DerivedBlockRefPair) {
 	defer cancel()
 	if err := m.Node.ProvideL1(nodeCtx, nextL1); err != nil {
 		m.log.Warn("Failed to provide next L1 block to node", "err", err)
+		m.reportNodeError(fmt.Errorf("provide L1 block: %w", err))
 		// We will reset the node if we receive a reset-event from it,
 		// which is fired if the provided L1 block was received successfully,
 		// but does not fit on the derivation state.
 		return
 	}
+	m.reportNodeSuccess()
 }
 
 // onInvalidateLocalSafe listens for when a local-safe block is found to be invalid in the cross-safe context
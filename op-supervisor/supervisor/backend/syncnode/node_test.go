@@ -0,0 +1,180 @@
+package syncnode
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestNodeErrBackoff_RecordEscalatesAndReset(t *testing.T) {
+	var b nodeErrBackoff
+	someErr := errors.New("boom")
+
+	var lastDelay time.Duration
+	for i := 1; i <= nodeErrUnhealthyThreshold; i++ {
+		delay, unhealthy := b.record(someErr)
+		if delay < lastDelay {
+			t.Fatalf("streak %d: delay %v should not shrink from previous %v", i, delay, lastDelay)
+		}
+		lastDelay = delay
+		if i < nodeErrUnhealthyThreshold && unhealthy {
+			t.Fatalf("streak %d: expected not yet unhealthy, got unhealthy", i)
+		}
+		if i == nodeErrUnhealthyThreshold && !unhealthy {
+			t.Fatalf("streak %d: expected unhealthy once threshold is reached", i)
+		}
+	}
+	if lastDelay > nodeErrBackoffMax {
+		t.Fatalf("delay %v exceeded nodeErrBackoffMax %v", lastDelay, nodeErrBackoffMax)
+	}
+
+	b.reset()
+	delay, unhealthy := b.record(someErr)
+	if unhealthy {
+		t.Fatalf("expected a single failure after reset to not be unhealthy")
+	}
+	if delay != nodeErrBackoffBase {
+		t.Fatalf("expected first delay after reset to be the base delay %v, got %v", nodeErrBackoffBase, delay)
+	}
+}
+
+func TestNodeErrBackoff_BecameUnhealthyFiresOncePerTransition(t *testing.T) {
+	var b nodeErrBackoff
+	someErr := errors.New("boom")
+
+	for i := 1; i < nodeErrUnhealthyThreshold; i++ {
+		if _, becameUnhealthy := b.record(someErr); becameUnhealthy {
+			t.Fatalf("streak %d: expected no transition yet", i)
+		}
+	}
+	if _, becameUnhealthy := b.record(someErr); !becameUnhealthy {
+		t.Fatalf("expected the threshold-crossing failure to report a transition")
+	}
+	// Further failures while already unhealthy must not re-report, so the
+	// supervisor doesn't get a fresh ManagedNodeUnhealthyEvent per failure.
+	for i := 0; i < 3; i++ {
+		if _, becameUnhealthy := b.record(someErr); becameUnhealthy {
+			t.Fatalf("failure %d after the transition: expected no repeat transition", i)
+		}
+	}
+
+	b.reset()
+	for i := 1; i < nodeErrUnhealthyThreshold; i++ {
+		if _, becameUnhealthy := b.record(someErr); becameUnhealthy {
+			t.Fatalf("post-reset streak %d: expected no transition yet", i)
+		}
+	}
+	if _, becameUnhealthy := b.record(someErr); !becameUnhealthy {
+		t.Fatalf("expected a new transition after reset once the threshold is crossed again")
+	}
+}
+
+func TestResetBackoff_ReadyGatesWithoutBlocking(t *testing.T) {
+	var b resetBackoff
+	if !b.ready() {
+		t.Fatalf("expected ready before any failure")
+	}
+
+	b.recordFailure()
+	if b.ready() {
+		t.Fatalf("expected not ready immediately after a failure")
+	}
+
+	b.recordSuccess()
+	if !b.ready() {
+		t.Fatalf("expected ready again after a success clears the streak")
+	}
+}
+
+func TestResetBackoff_RecordFailureEscalates(t *testing.T) {
+	var b resetBackoff
+	var lastDelay time.Duration
+	for i := 0; i < 3; i++ {
+		delay := b.recordFailure()
+		if delay < lastDelay {
+			t.Fatalf("iteration %d: delay %v should not shrink from previous %v", i, delay, lastDelay)
+		}
+		lastDelay = delay
+		if delay > nodeErrBackoffMax {
+			t.Fatalf("iteration %d: delay %v exceeded nodeErrBackoffMax %v", i, delay, nodeErrBackoffMax)
+		}
+	}
+}
+
+func fakeAncestor(ancestor uint64) (predicate func(uint64) (bool, bool, error), probed *[]uint64) {
+	var calls []uint64
+	return func(number uint64) (resolved bool, needsWalkback bool, err error) {
+		calls = append(calls, number)
+		if number <= ancestor {
+			return true, false, nil
+		}
+		return false, true, fmt.Errorf("block %d not found", number)
+	}, &calls
+}
+
+func TestResolveConflictSearch(t *testing.T) {
+	t.Run("finds the ancestor in the interior of the range", func(t *testing.T) {
+		predicate, _ := fakeAncestor(50)
+		target, ok, err := resolveConflictSearch(0, 100, predicate)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !ok {
+			t.Fatalf("expected a resolved target")
+		}
+		if target != 50 {
+			t.Fatalf("expected target 50, got %d", target)
+		}
+	})
+
+	t.Run("a confirmed bisection success is returned even if the linear probe finds nothing better", func(t *testing.T) {
+		predicate, probed := fakeAncestor(50)
+		target, ok, err := resolveConflictSearch(0, 100, predicate)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !ok {
+			t.Fatalf("expected ok=true: a reset at block 50 succeeded during bisection and was never undone")
+		}
+		if target != 50 {
+			t.Fatalf("expected the confirmed target 50, got %d", target)
+		}
+		if len(*probed) == 0 {
+			t.Fatalf("expected tryReset to have been called")
+		}
+	})
+
+	t.Run("ancestor at the finalized floor is reported as not found", func(t *testing.T) {
+		predicate, _ := fakeAncestor(0)
+		_, ok, err := resolveConflictSearch(0, 100, predicate)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if ok {
+			t.Fatalf("expected ok=false when the only valid target is the finalized floor")
+		}
+	})
+
+	t.Run("a non-walkable error aborts the search immediately", func(t *testing.T) {
+		abortErr := errors.New("connection refused")
+		predicate := func(number uint64) (bool, bool, error) {
+			return false, false, abortErr
+		}
+		_, _, err := resolveConflictSearch(0, 100, predicate)
+		if !errors.Is(err, abortErr) {
+			t.Fatalf("expected the non-walkable error to be returned, got %v", err)
+		}
+	})
+
+	t.Run("a window already within the linear-probe depth is still solved", func(t *testing.T) {
+		predicate, _ := fakeAncestor(1)
+		target, ok, err := resolveConflictSearch(0, 3, predicate)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !ok || target != 1 {
+			t.Fatalf("expected ok=true and target 1, got ok=%v target=%d", ok, target)
+		}
+	})
+}
@@ -0,0 +1,212 @@
+package syncnode
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-supervisor/supervisor/types"
+)
+
+func TestAdaptivePoller_PollOnceTreatsEOFAsEmptyPoll(t *testing.T) {
+	sink := make(chan *types.ManagedEvent, 1)
+	p := &adaptivePoller{
+		pull: func(ctx context.Context) (*types.ManagedEvent, error) {
+			return nil, io.EOF
+		},
+		sink: sink,
+	}
+	gotEvent, err := p.pollOnce(context.Background())
+	if err != nil {
+		t.Fatalf("expected io.EOF to be treated as an empty poll, got err %v", err)
+	}
+	if gotEvent {
+		t.Fatalf("expected gotEvent=false on an empty poll")
+	}
+}
+
+func TestAdaptivePoller_PollOnceReturnsRealErrors(t *testing.T) {
+	boom := errors.New("boom")
+	p := &adaptivePoller{
+		pull: func(ctx context.Context) (*types.ManagedEvent, error) {
+			return nil, boom
+		},
+	}
+	_, err := p.pollOnce(context.Background())
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected the underlying error to be returned, got %v", err)
+	}
+}
+
+func TestAdaptivePoller_PollOnceDeliversEvent(t *testing.T) {
+	sink := make(chan *types.ManagedEvent, 1)
+	ev := &types.ManagedEvent{}
+	p := &adaptivePoller{
+		pull: func(ctx context.Context) (*types.ManagedEvent, error) {
+			return ev, nil
+		},
+		sink: sink,
+	}
+	gotEvent, err := p.pollOnce(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !gotEvent {
+		t.Fatalf("expected gotEvent=true when an event is delivered")
+	}
+	select {
+	case got := <-sink:
+		if got != ev {
+			t.Fatalf("expected the sink to receive the polled event")
+		}
+	default:
+		t.Fatalf("expected the event to already be on the sink")
+	}
+}
+
+// controlledPull lets a test drive an adaptivePoller.loop goroutine one pull
+// at a time: each call to pull signals entry on calls (synchronizing with
+// the test goroutine) and then blocks for an answer. Since loop only ever
+// touches p.interval between returning from pull and calling it again,
+// receiving on calls establishes a happens-before relationship with the
+// interval update from the previous iteration, making it safe for the test
+// to read p.interval right after.
+type controlledPull struct {
+	calls   chan struct{}
+	answers chan pullAnswer
+}
+
+type pullAnswer struct {
+	ev  *types.ManagedEvent
+	err error
+}
+
+func newControlledPull() *controlledPull {
+	return &controlledPull{
+		calls:   make(chan struct{}),
+		answers: make(chan pullAnswer),
+	}
+}
+
+func (c *controlledPull) pull(ctx context.Context) (*types.ManagedEvent, error) {
+	select {
+	case c.calls <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	select {
+	case a := <-c.answers:
+		return a.ev, a.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *controlledPull) awaitCall(t *testing.T) {
+	t.Helper()
+	select {
+	case <-c.calls:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("timed out waiting for the poller to call pull")
+	}
+}
+
+func TestAdaptivePoller_ShrinksIntervalOnEvent(t *testing.T) {
+	ctrl := newControlledPull()
+	sink := make(chan *types.ManagedEvent, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := newAdaptivePoller(ctx, ctrl.pull, pollBaseInterval, sink)
+	defer p.Unsubscribe()
+
+	ctrl.awaitCall(t)
+	ctrl.answers <- pullAnswer{ev: &types.ManagedEvent{}}
+	<-sink
+
+	// Wait for the next pull call: by then loop has already halved the
+	// interval and reset its timer for the new (shorter) duration.
+	ctrl.awaitCall(t)
+	if want := pollBaseInterval / 2; p.interval != want {
+		t.Fatalf("expected interval to halve to %v after an event, got %v", want, p.interval)
+	}
+	ctrl.answers <- pullAnswer{err: io.EOF}
+}
+
+func TestAdaptivePoller_GrowsIntervalOnEmptyPoll(t *testing.T) {
+	ctrl := newControlledPull()
+	sink := make(chan *types.ManagedEvent, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := newAdaptivePoller(ctx, ctrl.pull, pollBaseInterval, sink)
+	defer p.Unsubscribe()
+
+	ctrl.awaitCall(t)
+	ctrl.answers <- pullAnswer{err: io.EOF}
+
+	ctrl.awaitCall(t)
+	if want := pollBaseInterval + pollBaseInterval; p.interval != want {
+		t.Fatalf("expected interval to grow to %v after an empty poll, got %v", want, p.interval)
+	}
+	ctrl.answers <- pullAnswer{err: io.EOF}
+}
+
+func TestAdaptivePoller_ClampsToFloorOnEvent(t *testing.T) {
+	ctrl := newControlledPull()
+	sink := make(chan *types.ManagedEvent, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Start already at the floor: halving would go below it.
+	p := newAdaptivePoller(ctx, ctrl.pull, pollFloorInterval, sink)
+	defer p.Unsubscribe()
+
+	ctrl.awaitCall(t)
+	ctrl.answers <- pullAnswer{ev: &types.ManagedEvent{}}
+	<-sink
+
+	ctrl.awaitCall(t)
+	if p.interval != pollFloorInterval {
+		t.Fatalf("expected interval to stay clamped at the floor %v, got %v", pollFloorInterval, p.interval)
+	}
+	ctrl.answers <- pullAnswer{err: io.EOF}
+}
+
+func TestAdaptivePoller_ClampsToCeilOnEmptyPoll(t *testing.T) {
+	ctrl := newControlledPull()
+	sink := make(chan *types.ManagedEvent, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Start already at the ceiling: growing would go past it.
+	p := newAdaptivePoller(ctx, ctrl.pull, pollCeilInterval, sink)
+	defer p.Unsubscribe()
+
+	ctrl.awaitCall(t)
+	ctrl.answers <- pullAnswer{err: io.EOF}
+
+	ctrl.awaitCall(t)
+	if p.interval != pollCeilInterval {
+		t.Fatalf("expected interval to stay clamped at the ceiling %v, got %v", pollCeilInterval, p.interval)
+	}
+	ctrl.answers <- pullAnswer{err: io.EOF}
+}
+
+func TestAdaptivePoller_KickPollsBeforePendingTimer(t *testing.T) {
+	ctrl := newControlledPull()
+	sink := make(chan *types.ManagedEvent, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A long interval means the pending timer won't fire on its own within
+	// the test; Kick must stop it and poll at once instead of waiting for the tick.
+	p := newAdaptivePoller(ctx, ctrl.pull, time.Hour, sink)
+	defer p.Unsubscribe()
+
+	p.Kick()
+	ctrl.awaitCall(t)
+	ctrl.answers <- pullAnswer{err: io.EOF}
+}